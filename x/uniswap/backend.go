@@ -0,0 +1,43 @@
+package uniswap
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolBackend abstracts the pricing and settlement mechanics of a reserve pool so that governance
+// can whitelist alternative AMM curves (stableswap, transmuter-style 1:1, weighted pools, or an
+// externally programmed CosmWasm contract) without forking the swap and liquidity handlers. Every
+// Pool names the backend that prices and settles it (see Pool.Backend); the keeper dispatches to
+// the matching implementation via backendFor.
+type PoolBackend interface {
+	// SwapExactAmountIn swaps exactly inputAmt of inputDenom out of sender for outputDenom,
+	// settling funds with pool, and returns the amount of outputDenom received.
+	SwapExactAmountIn(ctx sdk.Context, pool Pool, sender sdk.AccAddress, inputAmt sdk.Int, inputDenom, outputDenom string) (sdk.Int, sdk.Error)
+
+	// SwapExactAmountOut swaps whatever amount of inputDenom is required out of sender for
+	// exactly outputAmt of outputDenom, settling funds with pool, and returns the amount of
+	// inputDenom paid.
+	SwapExactAmountOut(ctx sdk.Context, pool Pool, sender sdk.AccAddress, outputAmt sdk.Int, inputDenom, outputDenom string) (sdk.Int, sdk.Error)
+
+	// JoinPool deposits amt of denom from sender into pool and returns the number of LP shares
+	// minted for the deposit.
+	JoinPool(ctx sdk.Context, pool Pool, sender sdk.AccAddress, denom string, amt sdk.Int) (sdk.Int, sdk.Error)
+
+	// ExitPool burns lptAmt of the sender's LP shares and returns the reserves paid out.
+	ExitPool(ctx sdk.Context, pool Pool, sender sdk.AccAddress, lptAmt sdk.Int) (sdk.Coins, sdk.Error)
+
+	// SpotPrice returns the current marginal price of one unit of quoteDenom, denominated in
+	// baseDenom.
+	SpotPrice(ctx sdk.Context, pool Pool, baseDenom, quoteDenom string) (sdk.Dec, sdk.Error)
+
+	// GetReserves returns pool's current balance of denom.
+	GetReserves(ctx sdk.Context, pool Pool, denom string) sdk.Int
+}
+
+// backendFor returns the PoolBackend implementation that prices and settles pool
+func (k Keeper) backendFor(pool Pool) PoolBackend {
+	if pool.Backend == BackendCosmWasm {
+		return cosmWasmBackend{k}
+	}
+	return constantProductBackend{k}
+}
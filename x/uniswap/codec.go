@@ -0,0 +1,23 @@
+package uniswap
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the uniswap message types on the provided Codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgSwapOrder{}, "uniswap/Swap", nil)
+	cdc.RegisterConcrete(MsgAddLiquidity{}, "uniswap/AddLiquidity", nil)
+	cdc.RegisterConcrete(MsgRemoveLiquidity{}, "uniswap/RemoveLiquidity", nil)
+	cdc.RegisterConcrete(MsgAddUnilateralLiquidity{}, "uniswap/AddUnilateralLiquidity", nil)
+}
+
+// ModuleCdc is the codec used by the uniswap module to marshal and unmarshal
+// messages and genesis state
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,138 @@
+package uniswap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constantProductBackend is the default PoolBackend, pricing swaps with the x*y=k formula
+// introduced by Uniswap v1 and settling every operation directly against the pool's own escrow
+// account.
+type constantProductBackend struct {
+	k Keeper
+}
+
+var _ PoolBackend = constantProductBackend{}
+
+func (b constantProductBackend) SwapExactAmountIn(ctx sdk.Context, pool Pool, sender sdk.AccAddress, inputAmt sdk.Int, inputDenom, outputDenom string) (sdk.Int, sdk.Error) {
+	k := b.k
+	outputAmt, err := getOutputAmount(ctx, k, pool, inputAmt, inputDenom, outputDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.bk.SendCoins(ctx, sender, pool.EscrowAddress, sdk.NewCoins(sdk.NewCoin(inputDenom, inputAmt))); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.bk.SendCoins(ctx, pool.EscrowAddress, sender, sdk.NewCoins(sdk.NewCoin(outputDenom, outputAmt))); err != nil {
+		return sdk.Int{}, err
+	}
+	return outputAmt, nil
+}
+
+func (b constantProductBackend) SwapExactAmountOut(ctx sdk.Context, pool Pool, sender sdk.AccAddress, outputAmt sdk.Int, inputDenom, outputDenom string) (sdk.Int, sdk.Error) {
+	k := b.k
+	inputAmt, err := getInputAmount(ctx, k, pool, outputAmt, inputDenom, outputDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.bk.SendCoins(ctx, sender, pool.EscrowAddress, sdk.NewCoins(sdk.NewCoin(inputDenom, inputAmt))); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.bk.SendCoins(ctx, pool.EscrowAddress, sender, sdk.NewCoins(sdk.NewCoin(outputDenom, outputAmt))); err != nil {
+		return sdk.Int{}, err
+	}
+	return inputAmt, nil
+}
+
+// JoinPool deposits a single side of the pair, acquiring the other side internally via the
+// virtual-swap mechanics of calcUnilateralSwapAmount before minting LP shares against the
+// resulting balanced deposit.
+func (b constantProductBackend) JoinPool(ctx sdk.Context, pool Pool, sender sdk.AccAddress, denom string, amt sdk.Int) (sdk.Int, sdk.Error) {
+	k := b.k
+	if denom != pool.Standard && denom != pool.Counterparty {
+		return sdk.Int{}, ErrEqualDenom(DefaultCodespace, fmt.Sprintf("deposit denom %s is not part of the %s pool", denom, pool.Id))
+	}
+
+	depositReserve := k.GetReservePool(ctx, pool, denom)
+	lptSupply := k.getLptSupply(ctx, pool.LptDenom)
+	fee := k.GetFee(ctx)
+
+	// swapAmt is the portion of the deposit that is virtually swapped into the other side of the
+	// pool so that the remainder of the deposit is left proportional to the pool's new reserves
+	swapAmt := calcUnilateralSwapAmount(amt, depositReserve, fee)
+	if !swapAmt.IsPositive() || swapAmt.GTE(amt) {
+		return sdk.Int{}, Err(DefaultCodespace, "unable to price unilateral deposit of %s against reserve %s", amt, depositReserve)
+	}
+
+	// shares minted mirror the standard add-liquidity formula applied to the swapped portion:
+	// T * swapAmt * (1 - fee) / (depositReserve + swapAmt * (1 - fee))
+	swapAmtWithFee := sdk.NewDecFromInt(swapAmt).Mul(sdk.OneDec().Sub(fee))
+	mintedLpt := swapAmtWithFee.MulInt(lptSupply).Quo(sdk.NewDecFromInt(depositReserve).Add(swapAmtWithFee)).TruncateInt()
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amt))
+	if !k.bk.HasCoins(ctx, sender, coins) {
+		return sdk.Int{}, sdk.ErrInsufficientCoins("sender does not have sufficient funds to add liquidity")
+	}
+	// transfer the full single-sided deposit into the pool's escrow account; the virtual swap
+	// never leaves the escrow account, it is only used to price the LP shares minted here
+	if err := k.bk.SendCoins(ctx, sender, pool.EscrowAddress, coins); err != nil {
+		return sdk.Int{}, err
+	}
+
+	lptCoins := sdk.NewCoins(sdk.NewCoin(pool.LptDenom, mintedLpt))
+	if err := k.sk.MintCoins(ctx, ModuleName, lptCoins); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.sk.SendCoinsFromModuleToAccount(ctx, ModuleName, sender, lptCoins); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return mintedLpt, nil
+}
+
+// ExitPool burns lptAmt of the sender's LP shares and returns the proportional share of both
+// reserves from the pool's escrow account.
+func (b constantProductBackend) ExitPool(ctx sdk.Context, pool Pool, sender sdk.AccAddress, lptAmt sdk.Int) (sdk.Coins, sdk.Error) {
+	k := b.k
+	standardReserve := k.GetReservePool(ctx, pool, pool.Standard)
+	counterpartyReserve := k.GetReservePool(ctx, pool, pool.Counterparty)
+	lptSupply := k.getLptSupply(ctx, pool.LptDenom)
+
+	lptCoin := sdk.NewCoin(pool.LptDenom, lptAmt)
+	if !k.bk.HasCoins(ctx, sender, sdk.NewCoins(lptCoin)) {
+		return nil, sdk.ErrInsufficientCoins("sender does not have sufficient liquidity shares to withdraw")
+	}
+
+	standardWithdrawn := lptAmt.Mul(standardReserve).Quo(lptSupply)
+	counterpartyWithdrawn := lptAmt.Mul(counterpartyReserve).Quo(lptSupply)
+
+	lptCoins := sdk.NewCoins(lptCoin)
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, sender, ModuleName, lptCoins); err != nil {
+		return nil, err
+	}
+	if err := k.sk.BurnCoins(ctx, ModuleName, lptCoins); err != nil {
+		return nil, err
+	}
+
+	coinsWithdrawn := sdk.NewCoins(sdk.NewCoin(pool.Standard, standardWithdrawn), sdk.NewCoin(pool.Counterparty, counterpartyWithdrawn))
+	if err := k.bk.SendCoins(ctx, pool.EscrowAddress, sender, coinsWithdrawn); err != nil {
+		return nil, err
+	}
+
+	return coinsWithdrawn, nil
+}
+
+func (b constantProductBackend) SpotPrice(ctx sdk.Context, pool Pool, baseDenom, quoteDenom string) (sdk.Dec, sdk.Error) {
+	k := b.k
+	baseReserve := k.GetReservePool(ctx, pool, baseDenom)
+	quoteReserve := k.GetReservePool(ctx, pool, quoteDenom)
+	if quoteReserve.IsZero() {
+		return sdk.Dec{}, ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve of %s in pool %s", quoteDenom, pool.Id))
+	}
+	return sdk.NewDecFromInt(baseReserve).QuoInt(quoteReserve), nil
+}
+
+func (b constantProductBackend) GetReserves(ctx sdk.Context, pool Pool, denom string) sdk.Int {
+	return b.k.GetReservePool(ctx, pool, denom)
+}
@@ -0,0 +1,236 @@
+package uniswap
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// cosmWasmBackend is a PoolBackend that delegates all pricing and settlement to a CosmWasm
+// contract registered against the pool (pool.ContractAddress), letting governance whitelist
+// alternative AMM curves (stableswap, transmuter-style 1:1, weighted pools, ...) without forking
+// this module. Every operation is relayed to the contract as a sudo message; the contract reports
+// back how much of each side it consumed or produced, and this backend is responsible for moving
+// the underlying coins and for minting/burning this module's LP-share denom.
+type cosmWasmBackend struct {
+	k Keeper
+}
+
+var _ PoolBackend = cosmWasmBackend{}
+
+// wasmSwapSudoMsg is the sudo payload delivered to a pool contract to price and/or settle a swap.
+// DryRun requests a price quote only: the contract must not move funds or otherwise mutate its
+// own state when DryRun is set. In a quote and in SwapExactAmountIn, exactly one of
+// InputAmount/OutputAmount is fixed by the caller; the other is passed as zero and is the side the
+// contract is being asked to solve for. The settling call of SwapExactAmountOut passes both: the
+// escrowed maximum input as InputAmount and the exact output as OutputAmount.
+type wasmSwapSudoMsg struct {
+	Swap *wasmSwapPayload `json:"swap"`
+}
+
+type wasmSwapPayload struct {
+	Sender       string  `json:"sender"`
+	InputDenom   string  `json:"input_denom"`
+	InputAmount  sdk.Int `json:"input_amount"`
+	OutputDenom  string  `json:"output_denom"`
+	OutputAmount sdk.Int `json:"output_amount"`
+	DryRun       bool    `json:"dry_run"`
+}
+
+type wasmSwapResponse struct {
+	InputAmount  sdk.Int `json:"input_amount"`
+	OutputAmount sdk.Int `json:"output_amount"`
+}
+
+func (b cosmWasmBackend) sudoSwap(ctx sdk.Context, pool Pool, sender sdk.AccAddress, inputDenom string, inputAmt sdk.Int, outputDenom string, outputAmt sdk.Int, dryRun bool) (wasmSwapResponse, sdk.Error) {
+	if b.k.wk == nil {
+		return wasmSwapResponse{}, Err(DefaultCodespace, "pool %s has no wasm keeper configured", pool.Id)
+	}
+	bz, jErr := json.Marshal(wasmSwapSudoMsg{Swap: &wasmSwapPayload{
+		Sender:       sender.String(),
+		InputDenom:   inputDenom,
+		InputAmount:  inputAmt,
+		OutputDenom:  outputDenom,
+		OutputAmount: outputAmt,
+		DryRun:       dryRun,
+	}})
+	if jErr != nil {
+		return wasmSwapResponse{}, sdk.ErrInternal(fmt.Sprintf("failed to marshal cosmwasm swap message: %s", jErr))
+	}
+	respBz, err := b.k.wk.Sudo(ctx, pool.ContractAddress, bz)
+	if err != nil {
+		return wasmSwapResponse{}, sdk.ErrInternal(fmt.Sprintf("cosmwasm pool contract rejected swap: %s", err))
+	}
+	var resp wasmSwapResponse
+	if err := json.Unmarshal(respBz, &resp); err != nil {
+		return wasmSwapResponse{}, sdk.ErrInternal(fmt.Sprintf("failed to unmarshal cosmwasm swap response: %s", err))
+	}
+	return resp, nil
+}
+
+// SwapExactAmountIn escrows the exact inputAmt into the contract's address, invokes the swap, and
+// pays out whatever the contract reports as OutputAmount.
+func (b cosmWasmBackend) SwapExactAmountIn(ctx sdk.Context, pool Pool, sender sdk.AccAddress, inputAmt sdk.Int, inputDenom, outputDenom string) (sdk.Int, sdk.Error) {
+	k := b.k
+	if err := k.bk.SendCoins(ctx, sender, pool.ContractAddress, sdk.NewCoins(sdk.NewCoin(inputDenom, inputAmt))); err != nil {
+		return sdk.Int{}, err
+	}
+	resp, err := b.sudoSwap(ctx, pool, sender, inputDenom, inputAmt, outputDenom, sdk.ZeroInt(), false)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.bk.SendCoins(ctx, pool.ContractAddress, sender, sdk.NewCoins(sdk.NewCoin(outputDenom, resp.OutputAmount))); err != nil {
+		return sdk.Int{}, err
+	}
+	return resp.OutputAmount, nil
+}
+
+// SwapExactAmountOut first asks the contract to quote the input its curve currently requires for
+// outputAmt, escrows that token_in_max_amount into the contract's address up front, then invokes
+// the swap itself and sweeps back whatever of the escrow the contract did not actually consume.
+// Escrowing before the swap call (rather than paying the contract its exact due afterwards)
+// matters for contracts like transmuter that require the tokens already on hand to execute.
+func (b cosmWasmBackend) SwapExactAmountOut(ctx sdk.Context, pool Pool, sender sdk.AccAddress, outputAmt sdk.Int, inputDenom, outputDenom string) (sdk.Int, sdk.Error) {
+	k := b.k
+
+	quote, err := b.sudoSwap(ctx, pool, sender, inputDenom, sdk.ZeroInt(), outputDenom, outputAmt, true)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	tokenInMaxAmount := quote.InputAmount
+
+	if err := k.bk.SendCoins(ctx, sender, pool.ContractAddress, sdk.NewCoins(sdk.NewCoin(inputDenom, tokenInMaxAmount))); err != nil {
+		return sdk.Int{}, err
+	}
+
+	resp, sErr := b.sudoSwap(ctx, pool, sender, inputDenom, tokenInMaxAmount, outputDenom, outputAmt, false)
+	if sErr != nil {
+		return sdk.Int{}, sErr
+	}
+
+	if refund := tokenInMaxAmount.Sub(resp.InputAmount); refund.IsPositive() {
+		if err := k.bk.SendCoins(ctx, pool.ContractAddress, sender, sdk.NewCoins(sdk.NewCoin(inputDenom, refund))); err != nil {
+			return sdk.Int{}, err
+		}
+	}
+	if err := k.bk.SendCoins(ctx, pool.ContractAddress, sender, sdk.NewCoins(sdk.NewCoin(outputDenom, resp.OutputAmount))); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return resp.InputAmount, nil
+}
+
+// wasmJoinSudoMsg/wasmExitSudoMsg mirror wasmSwapSudoMsg for the liquidity operations: the
+// contract reports how many LP shares a deposit earns, or how many underlying coins a burn of LP
+// shares pays out, and this backend performs the corresponding bank transfer and LP-share
+// mint/burn.
+type wasmJoinSudoMsg struct {
+	Join *wasmJoinPayload `json:"join"`
+}
+
+type wasmJoinPayload struct {
+	Sender string  `json:"sender"`
+	Denom  string  `json:"denom"`
+	Amount sdk.Int `json:"amount"`
+}
+
+type wasmJoinResponse struct {
+	LptAmount sdk.Int `json:"lpt_amount"`
+}
+
+type wasmExitSudoMsg struct {
+	Exit *wasmExitPayload `json:"exit"`
+}
+
+type wasmExitPayload struct {
+	Sender    string  `json:"sender"`
+	LptAmount sdk.Int `json:"lpt_amount"`
+}
+
+type wasmExitResponse struct {
+	Coins sdk.Coins `json:"coins"`
+}
+
+func (b cosmWasmBackend) JoinPool(ctx sdk.Context, pool Pool, sender sdk.AccAddress, denom string, amt sdk.Int) (sdk.Int, sdk.Error) {
+	k := b.k
+	if k.wk == nil {
+		return sdk.Int{}, Err(DefaultCodespace, "pool %s has no wasm keeper configured", pool.Id)
+	}
+	if err := k.bk.SendCoins(ctx, sender, pool.ContractAddress, sdk.NewCoins(sdk.NewCoin(denom, amt))); err != nil {
+		return sdk.Int{}, err
+	}
+
+	bz, jErr := json.Marshal(wasmJoinSudoMsg{Join: &wasmJoinPayload{Sender: sender.String(), Denom: denom, Amount: amt}})
+	if jErr != nil {
+		return sdk.Int{}, sdk.ErrInternal(fmt.Sprintf("failed to marshal cosmwasm join message: %s", jErr))
+	}
+	respBz, err := k.wk.Sudo(ctx, pool.ContractAddress, bz)
+	if err != nil {
+		return sdk.Int{}, sdk.ErrInternal(fmt.Sprintf("cosmwasm pool contract rejected join: %s", err))
+	}
+	var resp wasmJoinResponse
+	if jErr := json.Unmarshal(respBz, &resp); jErr != nil {
+		return sdk.Int{}, sdk.ErrInternal(fmt.Sprintf("failed to unmarshal cosmwasm join response: %s", jErr))
+	}
+
+	lptCoins := sdk.NewCoins(sdk.NewCoin(pool.LptDenom, resp.LptAmount))
+	if err := k.sk.MintCoins(ctx, ModuleName, lptCoins); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.sk.SendCoinsFromModuleToAccount(ctx, ModuleName, sender, lptCoins); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return resp.LptAmount, nil
+}
+
+func (b cosmWasmBackend) ExitPool(ctx sdk.Context, pool Pool, sender sdk.AccAddress, lptAmt sdk.Int) (sdk.Coins, sdk.Error) {
+	k := b.k
+	if k.wk == nil {
+		return nil, Err(DefaultCodespace, "pool %s has no wasm keeper configured", pool.Id)
+	}
+
+	lptCoins := sdk.NewCoins(sdk.NewCoin(pool.LptDenom, lptAmt))
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, sender, ModuleName, lptCoins); err != nil {
+		return nil, err
+	}
+	if err := k.sk.BurnCoins(ctx, ModuleName, lptCoins); err != nil {
+		return nil, err
+	}
+
+	bz, jErr := json.Marshal(wasmExitSudoMsg{Exit: &wasmExitPayload{Sender: sender.String(), LptAmount: lptAmt}})
+	if jErr != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal cosmwasm exit message: %s", jErr))
+	}
+	respBz, err := k.wk.Sudo(ctx, pool.ContractAddress, bz)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("cosmwasm pool contract rejected exit: %s", err))
+	}
+	var resp wasmExitResponse
+	if jErr := json.Unmarshal(respBz, &resp); jErr != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to unmarshal cosmwasm exit response: %s", jErr))
+	}
+
+	if err := k.bk.SendCoins(ctx, pool.ContractAddress, sender, resp.Coins); err != nil {
+		return nil, err
+	}
+	return resp.Coins, nil
+}
+
+// SpotPrice quotes a 1-unit dry-run swap of quoteDenom into baseDenom; since the input is exactly
+// one unit, the quoted output amount is itself the price.
+func (b cosmWasmBackend) SpotPrice(ctx sdk.Context, pool Pool, baseDenom, quoteDenom string) (sdk.Dec, sdk.Error) {
+	quote, err := b.sudoSwap(ctx, pool, pool.ContractAddress, quoteDenom, sdk.OneInt(), baseDenom, sdk.ZeroInt(), true)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !quote.OutputAmount.IsPositive() {
+		return sdk.Dec{}, Err(DefaultCodespace, "cosmwasm pool contract returned a non-positive quote for pool %s", pool.Id)
+	}
+	return sdk.NewDecFromInt(quote.OutputAmount), nil
+}
+
+func (b cosmWasmBackend) GetReserves(ctx sdk.Context, pool Pool, denom string) sdk.Int {
+	return b.k.bk.GetCoins(ctx, pool.ContractAddress).AmountOf(denom)
+}
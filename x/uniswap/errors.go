@@ -0,0 +1,58 @@
+package uniswap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the uniswap module's codespace
+const DefaultCodespace sdk.CodespaceType = ModuleName
+
+// uniswap error codes
+const (
+	CodeInvalidDeadline   sdk.CodeType = 1
+	CodeNotPositive       sdk.CodeType = 2
+	CodeNonExistingPool   sdk.CodeType = 3
+	CodeExistingPool      sdk.CodeType = 4
+	CodeTooMuchSlippage   sdk.CodeType = 5
+	CodeEqualDenom        sdk.CodeType = 6
+	CodeInsufficientFunds sdk.CodeType = 7
+	CodeInternal          sdk.CodeType = 8
+)
+
+// ErrInvalidDeadline is raised when a message's deadline has already passed
+func ErrInvalidDeadline(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDeadline, msg)
+}
+
+// ErrNotPositive is raised when an amount that must be positive is not
+func ErrNotPositive(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeNotPositive, msg)
+}
+
+// ErrNonExistingPool is raised when a message targets a reserve pool that has not been created yet
+func ErrNonExistingPool(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeNonExistingPool, msg)
+}
+
+// ErrExistingPool is raised when a message would create a reserve pool that already exists
+func ErrExistingPool(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeExistingPool, msg)
+}
+
+// ErrTooMuchSlippage is raised when a trade or deposit would violate the sender's slippage bound
+func ErrTooMuchSlippage(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeTooMuchSlippage, msg)
+}
+
+// ErrEqualDenom is raised when a swap or pool is requested between a denom and itself, or when a
+// deposit names a denom that is not part of the target pool
+func ErrEqualDenom(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeEqualDenom, msg)
+}
+
+// Err is a generic internal uniswap error, formatted like fmt.Sprintf
+func Err(codespace sdk.CodespaceType, format string, args ...interface{}) sdk.Error {
+	return sdk.NewError(codespace, CodeInternal, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,22 @@
+package uniswap
+
+// uniswap module event types and attribute keys
+const (
+	EventTypeSwap            = "swap"
+	EventTypeAddLiquidity    = "add_liquidity"
+	EventTypeRemoveLiquidity = "remove_liquidity"
+
+	AttributeKeySender              = "sender"
+	AttributeKeyPoolId              = "pool_id"
+	AttributeKeyInputDenom          = "input_denom"
+	AttributeKeyInputAmount         = "input_amount"
+	AttributeKeyOutputDenom         = "output_denom"
+	AttributeKeyOutputAmount        = "output_amount"
+	AttributeKeyFeeAmount           = "fee_amount"
+	AttributeKeyStandardReserve     = "standard_reserve"
+	AttributeKeyCounterpartyReserve = "counterparty_reserve"
+	AttributeKeyLptDenom            = "lpt_denom"
+	AttributeKeyLptAmount           = "lpt_amount"
+
+	AttributeValueCategory = ModuleName
+)
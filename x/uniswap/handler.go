@@ -16,6 +16,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return HandleMsgAddLiquidity(ctx, msg, k)
 		case MsgRemoveLiquidity:
 			return HandleMsgRemoveLiquidity(ctx, msg, k)
+		case MsgAddUnilateralLiquidity:
+			return HandleMsgAddUnilateralLiquidity(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized uniswap message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -24,188 +26,476 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 // HandleMsgSwapOrder handler for MsgSwapOrder
+// The swap is carried out atomically across every hop in the resolved route: a single deadline
+// and slippage bound (Input.Amount for buy orders, Output.Amount for sell orders) apply end to
+// end. If the final slippage check fails, baseapp discards the whole (partially applied) state
+// change since HandleMsgSwapOrder returns an error without having already committed the
+// transaction, so there is no need to manually unwind earlier hops.
 func HandleMsgSwapOrder(ctx sdk.Context, msg MsgSwapOrder, k Keeper) sdk.Result {
-	var caclulatedAmount sdk.Int
-
 	// check that deadline has not passed
 	if ctx.BlockHeader().Time.After(msg.Deadline) {
 		return ErrInvalidDeadline(DefaultCodespace, "deadline has passed for MsgSwapOrder").Result()
 	}
 
+	route, rErr := resolveRoute(ctx, k, msg.Input.Denom, msg.Output.Denom, msg.Path)
+	if rErr != nil {
+		return rErr.Result()
+	}
+
 	if msg.IsBuyOrder {
-		calculatedAmount := getInputAmount(ctx, k, msg.Output.Amount, msg.Input.Denom, msg.Input.Denom)
-		// ensure the calculated amount is less than or equal to the amount
-		// the sender is willing to pay.
-		if !calculatedAmount.LTE(msg.Input.Amount) {
-			return ErrNotPositive(DefaultCodespace, fmt.Sprintf("maximum amount (%d) to be sold was exceeded (%d)", msg.Input.Amount, calculatedAmount)).Result()
+		inputAmt, err := executeRoute(ctx, k, msg.Sender, route, msg.Output.Amount, true)
+		if err != nil {
+			return err.Result()
 		}
-
-		coinSold := sdk.NewCoins(sdk.NewCoin(msg.Input.Denom, calculatedAmount))
-		if !k.bk.HasCoins(ctx, msg.Sender, coinSold) {
-			return sdk.ErrInsufficientCoins("sender account does not have sufficient funds to fulfill the swap order").Result()
+		// ensure the calculated input is less than or equal to the amount the sender is willing to pay
+		if !inputAmt.LTE(msg.Input.Amount) {
+			return ErrTooMuchSlippage(DefaultCodespace, fmt.Sprintf("maximum amount (%s) to be sold was exceeded (%s)", msg.Input.Amount, inputAmt)).Result()
 		}
-
-		err := k.sk.SendCoinsFromAccountToModule(ctx, msg.Sender, ModuleName, coinSold)
+	} else {
+		outputAmt, err := executeRoute(ctx, k, msg.Sender, route, msg.Input.Amount, false)
 		if err != nil {
 			return err.Result()
 		}
+		// ensure the calculated output is greater than or equal to the minimum amount the sender is willing to buy
+		if !outputAmt.GTE(msg.Output.Amount) {
+			return ErrTooMuchSlippage(DefaultCodespace, fmt.Sprintf("minimum amount (%s) to be bought was not met (%s)", msg.Output.Amount, outputAmt)).Result()
+		}
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
-		err = k.sk.SendCoinsFromModuleToAccount(ctx, ModuleName, msg.Sender, sdk.NewCoins(msg.Output))
+// executeRoute settles a swap across every hop in route for an exact amount of either route's
+// first denom (isBuyOrder false, a sell order) or route's last denom (isBuyOrder true, a buy
+// order). It returns the resulting amount of the side that was not fixed: outputAmt for a sell
+// order, inputAmt for a buy order.
+//
+// A direct route (a single hop) settles through its pool's PoolBackend, so a BackendCosmWasm pool
+// can still be swapped against directly. A longer route prices every hop with the plain
+// constant-product formula and forwards each hop's output straight into the next hop's pool,
+// rather than dispatching through backendFor, since PoolBackend's swap methods always settle
+// against the account they are given and so cannot forward funds pool to pool; sender is debited
+// once, for the route's first denom, and credited once, for the route's last denom, so
+// intermediate balances never pass back through the trader's own account.
+func executeRoute(ctx sdk.Context, k Keeper, sender sdk.AccAddress, route []string, amt sdk.Int, isBuyOrder bool) (sdk.Int, sdk.Error) {
+	firstPool, found := k.poolForHop(ctx, route[0], route[1])
+	if !found {
+		return sdk.Int{}, ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve pool for denom pair %s/%s", route[0], route[1]))
+	}
+
+	if len(route) == 2 {
+		backend := k.backendFor(firstPool)
+		if isBuyOrder {
+			inputAmt, err := backend.SwapExactAmountOut(ctx, firstPool, sender, amt, route[0], route[1])
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			emitSwapEvent(ctx, k, firstPool, sender, route[0], inputAmt, route[1], amt)
+			return inputAmt, nil
+		}
+		outputAmt, err := backend.SwapExactAmountIn(ctx, firstPool, sender, amt, route[0], route[1])
 		if err != nil {
-			return err.Result()
+			return sdk.Int{}, err
 		}
+		emitSwapEvent(ctx, k, firstPool, sender, route[0], amt, route[1], outputAmt)
+		return outputAmt, nil
+	}
 
+	var inputAmt sdk.Int
+	if isBuyOrder {
+		var err sdk.Error
+		inputAmt, err = getInputPrice(ctx, k, route, amt)
+		if err != nil {
+			return sdk.Int{}, err
+		}
 	} else {
-		calculatedAmount := getOutputAmount(ctx, k, msg.Input.Amount, msg.Input.Denom, msg.Output.Denom)
-		// ensure the calculated amount is greater than the minimum amount
-		// the sender is willing to buy.
-		if !calculatedAmount.GTE(msg.Output.Amount) {
-			// TODO: add custom error for these
-			return Err(DefaultCodespace, "minimum amount (%d) to be sold was not met (%d)", msg.Output.Amount, calculatedAmount).Result()
+		inputAmt = amt
+	}
+
+	// price every hop before any funds move, so no hop counts its own input as part of its reserves
+	hopOuts := make([]sdk.Int, 0, len(route)-1)
+	for i, priced := 0, inputAmt; i < len(route)-1; i++ {
+		out, err := getOutputPrice(ctx, k, route[i:i+2], priced)
+		if err != nil {
+			return sdk.Int{}, err
 		}
+		hopOuts, priced = append(hopOuts, out), out
+	}
 
-		coinSold := sdk.NewCoins(msg.Input)
-		if !k.bk.HasCoins(ctx, msg.Sender, coinSold) {
-			return sdk.ErrInsufficientCoins("sender account does not have sufficient funds to fulfill the swap order").Result()
+	if err := k.bk.SendCoins(ctx, sender, firstPool.EscrowAddress, sdk.NewCoins(sdk.NewCoin(route[0], inputAmt))); err != nil {
+		return sdk.Int{}, err
+	}
+
+	hopAmt := inputAmt
+	for i := 0; i < len(route)-1; i++ {
+		hopIn, hopOut := route[i], route[i+1]
+		pool, found := k.poolForHop(ctx, hopIn, hopOut)
+		if !found {
+			return sdk.Int{}, ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve pool for denom pair %s/%s", hopIn, hopOut))
+		}
+		hopOutAmt := hopOuts[i]
+
+		dest := sender
+		if i < len(route)-2 {
+			nextPool, found := k.poolForHop(ctx, hopOut, route[i+2])
+			if !found {
+				return sdk.Int{}, ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve pool for denom pair %s/%s", hopOut, route[i+2]))
+			}
+			dest = nextPool.EscrowAddress
+		}
+		if err := k.bk.SendCoins(ctx, pool.EscrowAddress, dest, sdk.NewCoins(sdk.NewCoin(hopOut, hopOutAmt))); err != nil {
+			return sdk.Int{}, err
 		}
 
-		err := k.sk.SendCoinsFromAccountToModule(ctx, msg.Sender, ModuleName, sdk.NewCoins(msg.Input))
-		if err != nil {
-			return err.Result()
+		emitSwapEvent(ctx, k, pool, sender, hopIn, hopAmt, hopOut, hopOutAmt)
+		hopAmt = hopOutAmt
+	}
+
+	if isBuyOrder {
+		return inputAmt, nil
+	}
+	return hopAmt, nil
+}
+
+// emitSwapEvent records a single hop of a swap against pool. fee_amount is only known for a
+// BackendConstantProduct pool, whose fee is the module's own k.GetFee(ctx) param; a BackendCosmWasm
+// pool's fee is entirely contract-determined, so the attribute is omitted rather than fabricated.
+func emitSwapEvent(ctx sdk.Context, k Keeper, pool Pool, sender sdk.AccAddress, inputDenom string, inputAmt sdk.Int, outputDenom string, outputAmt sdk.Int) {
+	backend := k.backendFor(pool)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyPoolId, pool.Id),
+		sdk.NewAttribute(AttributeKeyInputDenom, inputDenom),
+		sdk.NewAttribute(AttributeKeyInputAmount, inputAmt.String()),
+		sdk.NewAttribute(AttributeKeyOutputDenom, outputDenom),
+		sdk.NewAttribute(AttributeKeyOutputAmount, outputAmt.String()),
+	}
+	if pool.Backend == BackendConstantProduct {
+		feeAmt := sdk.NewDecFromInt(inputAmt).Mul(k.GetFee(ctx)).TruncateInt()
+		attrs = append(attrs, sdk.NewAttribute(AttributeKeyFeeAmount, feeAmt.String()))
+	}
+	attrs = append(attrs,
+		sdk.NewAttribute(AttributeKeyStandardReserve, backend.GetReserves(ctx, pool, pool.Standard).String()),
+		sdk.NewAttribute(AttributeKeyCounterpartyReserve, backend.GetReserves(ctx, pool, pool.Counterparty).String()),
+	)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeSwap, attrs...))
+}
+
+// resolveRoute builds the full hop path [inputDenom, path..., outputDenom]. When path is empty it
+// is inferred: direct if either side is the native asset, otherwise through the native asset.
+func resolveRoute(ctx sdk.Context, k Keeper, inputDenom, outputDenom string, path []string) ([]string, sdk.Error) {
+	if len(path) == 0 {
+		native := k.GetNativeDenom(ctx)
+		if inputDenom == native || outputDenom == native {
+			return []string{inputDenom, outputDenom}, nil
 		}
+		path = []string{native}
+	}
+	route := make([]string, 0, len(path)+2)
+	route = append(route, inputDenom)
+	route = append(route, path...)
+	route = append(route, outputDenom)
+	return route, nil
+}
 
-		err = k.sk.SendCoinsFromModuleToAccount(ctx, ModuleName, msg.Sender, sdk.NewCoins(sdk.NewCoin(msg.Output.Denom, calculatedAmount)))
+// poolForHop returns the pool pairing a hop's two denoms; uniswap pools always pair the native
+// asset against a counter-asset, so exactly one of a, b must be the native denom.
+func (k Keeper) poolForHop(ctx sdk.Context, a, b string) (Pool, bool) {
+	if a == k.GetNativeDenom(ctx) {
+		return k.GetPool(ctx, b)
+	}
+	return k.GetPool(ctx, a)
+}
+
+// getOutputPrice returns the amount of route's final denom received for an exact inputAmt of
+// route's first denom, composing each hop's constant-product pricing without moving any funds.
+func getOutputPrice(ctx sdk.Context, k Keeper, route []string, inputAmt sdk.Int) (sdk.Int, sdk.Error) {
+	amt := inputAmt
+	for i := 0; i < len(route)-1; i++ {
+		pool, found := k.poolForHop(ctx, route[i], route[i+1])
+		if !found {
+			return sdk.Int{}, ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve pool for denom pair %s/%s", route[i], route[i+1]))
+		}
+		var err sdk.Error
+		amt, err = getOutputAmount(ctx, k, pool, amt, route[i], route[i+1])
 		if err != nil {
-			return err.Result()
+			return sdk.Int{}, err
 		}
-
 	}
+	return amt, nil
+}
 
-	return sdk.Result{}
+// getInputPrice returns the amount of route's first denom required for an exact outputAmt of
+// route's final denom, composing each hop's constant-product pricing back to front without
+// moving any funds.
+func getInputPrice(ctx sdk.Context, k Keeper, route []string, outputAmt sdk.Int) (sdk.Int, sdk.Error) {
+	amt := outputAmt
+	for i := len(route) - 1; i > 0; i-- {
+		pool, found := k.poolForHop(ctx, route[i-1], route[i])
+		if !found {
+			return sdk.Int{}, ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve pool for denom pair %s/%s", route[i-1], route[i]))
+		}
+		var err sdk.Error
+		amt, err = getInputAmount(ctx, k, pool, amt, route[i-1], route[i])
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+	return amt, nil
 }
 
 // HandleMsgAddLiquidity handler for MsgAddLiquidity
-// If the reserve pool does not exist, it will be created.
+// If the reserve pool does not exist, it will be created. Two-sided deposits only make sense for
+// a constant-product pool, where both sides must be deposited in the pool's existing ratio; a
+// BackendCosmWasm pool is joined with MsgAddUnilateralLiquidity instead, leaving the contract free
+// to accept whatever single-asset deposit its own curve supports.
 func HandleMsgAddLiquidity(ctx sdk.Context, msg MsgAddLiquidity, k Keeper) sdk.Result {
 	// check that deadline has not passed
 	if ctx.BlockHeader().Time.After(msg.Deadline) {
 		return ErrInvalidDeadline(DefaultCodespace, "deadline has passed for MsgAddLiquidity").Result()
 	}
 
-	// create reserve pool if it does not exist
-	var coinLiquidity sdk.Int
-	if !k.HasReservePool(ctx, msg.Deposit.Denom) {
-		k.CreateReservePool(ctx, msg.Deposit.Denom)
-	} else {
-		coinLiquidity = k.GetReservePool(ctx, msg.Deposit.Denom)
+	nativeDenom := k.GetNativeDenom(ctx)
+	if msg.Deposit.Denom == nativeDenom {
+		return ErrEqualDenom(DefaultCodespace, fmt.Sprintf("deposit denom %s is the native denom; a pool pairs it against a counter-asset", msg.Deposit.Denom)).Result()
 	}
 
-	nativeLiquidity := k.GetReservePool(ctx, k.GetNativeDenom(ctx))
-	totalUNI := k.GetTotalUNI(ctx)
+	// create reserve pool if it does not exist
+	pool, found := k.GetPool(ctx, msg.Deposit.Denom)
+	if !found {
+		pool = k.CreateReservePool(ctx, msg.Deposit.Denom)
+	}
+	if pool.Backend != BackendConstantProduct {
+		return Err(DefaultCodespace, "two-sided deposits are not supported by the %s backend for pool %s; use MsgAddUnilateralLiquidity instead", pool.Backend, pool.Id).Result()
+	}
 
-	// calculate amount of UNI to be minted for sender
-	// and coin amount to be deposited
-	MintedUNI := (totalUNI.Mul(msg.DepositAmount)).Quo(nativeLiquidity)
-	coinAmountDeposited := (totalUNI.Mul(msg.DepositAmount)).Quo(nativeLiquidity)
-	nativeCoinDeposited := sdk.NewCoin(k.GetNativeDenom(ctx), msg.DepositAmount)
-	coinDeposited := sdk.NewCoin(msg.Deposit.Denom, coinAmountDeposited)
+	standardReserve := k.GetReservePool(ctx, pool, nativeDenom)
+	counterpartyReserve := k.GetReservePool(ctx, pool, msg.Deposit.Denom)
+	lptSupply := k.getLptSupply(ctx, pool.LptDenom)
+
+	// calculate the amount of LP shares to mint for the sender and the counter-asset amount to
+	// be deposited alongside msg.DepositAmount of the native asset
+	var mintedLpt sdk.Int
+	var coinDeposited sdk.Coin
+	if lptSupply.IsZero() {
+		// first deposit into the pool fixes its initial price, so both declared amounts are taken as-is
+		mintedLpt = msg.DepositAmount
+		coinDeposited = sdk.NewCoin(msg.Deposit.Denom, msg.Deposit.Amount)
+	} else {
+		mintedLpt = lptSupply.Mul(msg.DepositAmount).Quo(standardReserve)
+		coinDeposited = sdk.NewCoin(msg.Deposit.Denom, counterpartyReserve.Mul(msg.DepositAmount).Quo(standardReserve))
+		if coinDeposited.Amount.GT(msg.Deposit.Amount) {
+			return ErrTooMuchSlippage(DefaultCodespace, fmt.Sprintf("required deposit of %s exceeds the maximum %s", coinDeposited, msg.Deposit)).Result()
+		}
+	}
+	if mintedLpt.LT(msg.MinReward) {
+		return ErrTooMuchSlippage(DefaultCodespace, fmt.Sprintf("minted liquidity (%s) is less than the minimum requested (%s)", mintedLpt, msg.MinReward)).Result()
+	}
 
+	nativeCoinDeposited := sdk.NewCoin(nativeDenom, msg.DepositAmount)
 	coins := sdk.NewCoins(nativeCoinDeposited, coinDeposited)
 	if !k.bk.HasCoins(ctx, msg.Sender, coins) {
 		return sdk.ErrInsufficientCoins("sender does not have sufficient funds to add liquidity").Result()
 	}
 
-	// transfer deposited liquidity into uniswaps ModuleAccount
-	err := k.sk.SendCoinsFromAccountToModule(ctx, msg.Sender, ModuleName, coins)
-	if err != nil {
+	// transfer deposited liquidity into the pool's escrow account
+	if err := k.bk.SendCoins(ctx, msg.Sender, pool.EscrowAddress, coins); err != nil {
 		return err.Result()
 	}
 
-	// set updated total UNI
-	totalUNI = totalUNI.Add(MintedUNI)
-	k.SetTotalUNI(ctx, totalUNI)
-
-	// update senders account with minted UNI
-	UNIBalance := k.GetUNIForAddress(ctx, msg.Sender)
-	UNIBalance = UNIBalance.Add(MintedUNI)
-	k.SetUNIForAddress(ctx, UNIBalance)
+	// mint LP shares as ordinary bank coins and hand them to the sender
+	lptCoins := sdk.NewCoins(sdk.NewCoin(pool.LptDenom, mintedLpt))
+	if err := k.sk.MintCoins(ctx, ModuleName, lptCoins); err != nil {
+		return err.Result()
+	}
+	if err := k.sk.SendCoinsFromModuleToAccount(ctx, ModuleName, msg.Sender, lptCoins); err != nil {
+		return err.Result()
+	}
 
-	return sdk.Result{}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeAddLiquidity,
+		sdk.NewAttribute(AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(AttributeKeyPoolId, pool.Id),
+		sdk.NewAttribute(AttributeKeyInputDenom, nativeDenom),
+		sdk.NewAttribute(AttributeKeyInputAmount, msg.DepositAmount.String()),
+		sdk.NewAttribute(AttributeKeyOutputDenom, coinDeposited.Denom),
+		sdk.NewAttribute(AttributeKeyOutputAmount, coinDeposited.Amount.String()),
+		sdk.NewAttribute(AttributeKeyLptDenom, pool.LptDenom),
+		sdk.NewAttribute(AttributeKeyLptAmount, mintedLpt.String()),
+		sdk.NewAttribute(AttributeKeyStandardReserve, k.GetReservePool(ctx, pool, nativeDenom).String()),
+		sdk.NewAttribute(AttributeKeyCounterpartyReserve, k.GetReservePool(ctx, pool, msg.Deposit.Denom).String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
 // HandleMsgRemoveLiquidity handler for MsgRemoveLiquidity
+// Settlement is delegated to the pool's PoolBackend via ExitPool, so this handler works the same
+// way regardless of which backend priced and holds the pool's reserves.
 func HandleMsgRemoveLiquidity(ctx sdk.Context, msg MsgRemoveLiquidity, k Keeper) sdk.Result {
 	// check that deadline has not passed
 	if ctx.BlockHeader().Time.After(msg.Deadline) {
-		return ErrInvalidDeadline(DefaultCodespace, "deadline has passed for MsgRemoveLiquidity")
+		return ErrInvalidDeadline(DefaultCodespace, "deadline has passed for MsgRemoveLiquidity").Result()
 	}
 
 	// check if reserve pool exists
-	coinLiquidity, err := k.GetReservePool(ctx, msg.Withdraw.Denom)
-	if err != nil {
-		panic(fmt.Sprintf("error retrieving total liquidity for denomination: %s", msg.Withdraw.Denom))
+	pool, found := k.GetPool(ctx, msg.Withdraw.Denom)
+	if !found {
+		return ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve pool for denom %s", msg.Withdraw.Denom)).Result()
 	}
 
-	nativeLiquidity, err := k.GetReservePool(ctx, NativeAsset)
+	backend := k.backendFor(pool)
+	withdrawn, err := backend.ExitPool(ctx, pool, msg.Sender, msg.WithdrawAmount)
 	if err != nil {
-		panic("error retrieving native asset total liquidity")
+		return err.Result()
 	}
 
-	totalUNI, err := k.GetTotalUNI(ctx)
-	if err != nil {
-		panic("error retrieving total UNI")
+	nativeDenom := k.GetNativeDenom(ctx)
+	nativeWithdrawn := withdrawn.AmountOf(nativeDenom)
+	if nativeWithdrawn.LT(msg.MinNative) {
+		return ErrTooMuchSlippage(DefaultCodespace, fmt.Sprintf("withdrawn native amount (%s) is less than the minimum requested (%s)", nativeWithdrawn, msg.MinNative)).Result()
 	}
 
-	// calculate amount of UNI to be burned for sender
-	// and coin amount to be returned
-	nativeWithdrawn := msg.WithdrawAmount.Mul(nativeLiquidity).Quo(totalUNI)
-	coinWithdrawn := msg.WithdrawAmount.Mul(coinLiqudity).Quo(totalUNI)
-	nativeCoin := sdk.NewCoin(nativeDenom, nativeWithdrawn)
-	exchangeCoin = sdk.NewCoin(msg.Withdraw.Denom, coinWithdrawn)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeRemoveLiquidity,
+		sdk.NewAttribute(AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(AttributeKeyPoolId, pool.Id),
+		sdk.NewAttribute(AttributeKeyLptDenom, pool.LptDenom),
+		sdk.NewAttribute(AttributeKeyLptAmount, msg.WithdrawAmount.String()),
+		sdk.NewAttribute(AttributeKeyOutputDenom, nativeDenom),
+		sdk.NewAttribute(AttributeKeyOutputAmount, nativeWithdrawn.String()),
+		sdk.NewAttribute(AttributeKeyStandardReserve, backend.GetReserves(ctx, pool, pool.Standard).String()),
+		sdk.NewAttribute(AttributeKeyCounterpartyReserve, backend.GetReserves(ctx, pool, pool.Counterparty).String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// HandleMsgAddUnilateralLiquidity handler for MsgAddUnilateralLiquidity
+// Unlike HandleMsgAddLiquidity, the sender deposits only one side of the pair; settlement and the
+// mechanics of acquiring the other side (a virtual swap for a constant-product pool, whatever the
+// contract implements for a BackendCosmWasm pool) are delegated to the pool's PoolBackend via
+// JoinPool.
+func HandleMsgAddUnilateralLiquidity(ctx sdk.Context, msg MsgAddUnilateralLiquidity, k Keeper) sdk.Result {
+	// check that deadline has not passed
+	if ctx.BlockHeader().Time.After(msg.Deadline) {
+		return ErrInvalidDeadline(DefaultCodespace, "deadline has passed for MsgAddUnilateralLiquidity").Result()
+	}
+
+	// the target pool must already exist; unilateral liquidity cannot bootstrap a new pool
+	// since there is no existing price to swap the deposit against
+	pool, found := k.GetPool(ctx, msg.PoolDenom)
+	if !found {
+		return ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve pool for denom %s", msg.PoolDenom)).Result()
+	}
+
+	nativeDenom := k.GetNativeDenom(ctx)
+	if msg.Deposit.Denom != msg.PoolDenom && msg.Deposit.Denom != nativeDenom {
+		return ErrEqualDenom(DefaultCodespace, fmt.Sprintf("deposit denom %s is not part of the %s pool", msg.Deposit.Denom, msg.PoolDenom)).Result()
+	}
 
-	// transfer withdrawn liquidity from uniswaps ModuleAccount to sender's account
-	err = k.sk.SendCoinsFromModuleToAccount(ctx, msg.Sender, ModuleName, sdk.NewCoins(nativeCoin, coinDeposited))
+	backend := k.backendFor(pool)
+	mintedLpt, err := backend.JoinPool(ctx, pool, msg.Sender, msg.Deposit.Denom, msg.Deposit.Amount)
 	if err != nil {
 		return err.Result()
 	}
+	if mintedLpt.LT(msg.MinLiquidity) {
+		return ErrTooMuchSlippage(DefaultCodespace, fmt.Sprintf("minted liquidity (%s) is less than the minimum requested (%s)", mintedLpt, msg.MinLiquidity)).Result()
+	}
 
-	// set updated total UNI
-	totalUNI = totalUNI.Add(MintedUNI)
-	k.SetTotalUNI(ctx, totalUNI)
-
-	// update senders account with minted UNI
-	UNIBalance := k.GetUNIForAddress(ctx, msg.Sender)
-	UNIBalance = UNIBalance.Add(MintedUNI)
-	k.SetUNIForAddress(ctx, UNIBalance)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeAddLiquidity,
+		sdk.NewAttribute(AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(AttributeKeyPoolId, pool.Id),
+		sdk.NewAttribute(AttributeKeyInputDenom, msg.Deposit.Denom),
+		sdk.NewAttribute(AttributeKeyInputAmount, msg.Deposit.Amount.String()),
+		sdk.NewAttribute(AttributeKeyLptDenom, pool.LptDenom),
+		sdk.NewAttribute(AttributeKeyLptAmount, mintedLpt.String()),
+		sdk.NewAttribute(AttributeKeyStandardReserve, backend.GetReserves(ctx, pool, nativeDenom).String()),
+		sdk.NewAttribute(AttributeKeyCounterpartyReserve, backend.GetReserves(ctx, pool, msg.PoolDenom).String()),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
-	return sdk.Result{}
+// calcUnilateralSwapAmount solves for the portion s of a single-sided deposit d into a pool with
+// reserve x (of the same denom) that must be virtually swapped, at fee rate fee, so that the
+// remaining d - s is left proportional to the resulting reserves. Starting from the constant
+// product swap formula this reduces to the quadratic m*s^2 + x*(1+m)*s - d*x = 0, where
+// m = 1 - fee, solved here via the positive root of the quadratic formula.
+func calcUnilateralSwapAmount(d, x sdk.Int, fee sdk.Dec) sdk.Int {
+	m := sdk.OneDec().Sub(fee)
+	dDec := sdk.NewDecFromInt(d)
+	xDec := sdk.NewDecFromInt(x)
+
+	onePlusM := sdk.OneDec().Add(m)
+	b := xDec.Mul(onePlusM)
+	// discriminant = x^2*(1+m)^2 + 4*m*d*x
+	discriminant := xDec.Mul(xDec).Mul(onePlusM).Mul(onePlusM).Add(m.MulInt64(4).Mul(dDec).Mul(xDec))
+	sqrtDiscriminant, err := discriminant.ApproxSqrt()
+	if err != nil {
+		return sdk.ZeroInt()
+	}
+	s := sqrtDiscriminant.Sub(b).Quo(m.MulInt64(2))
+	if s.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return s.TruncateInt()
 }
 
-// GetInputAmount returns the amount of coins sold (calculated) given the output amount being bought (exact)
-// The fee is included in the output coins being bought
+// getInputAmount returns the exact amount of inputDenom that must be sold to receive exactly
+// outputAmt of outputDenom from pool, including the pool fee on the traded amount:
+//
+//	inputAmt = ceil( inputReserve * outputAmt / ((outputReserve - outputAmt) * (1 - fee)) ) + 1
+//
+// The ceiling and trailing +1 round in the pool's favor so repeated trades cannot drain value
+// through truncation. outputAmt must be strictly less than outputReserve, or there is no finite
+// input that buys it.
 // https://github.com/runtimeverification/verified-smart-contracts/blob/uniswap/uniswap/x-y-k.pdf
-// TODO: replace FeeD and FeeN with updated formula using fee as sdk.Dec
-func getInputAmount(ctx sdk.Context, k Keeper, outputAmt sdk.Int, inputDenom, outputDenom string) sdk.Int {
-	inputReserve := k.GetReservePool(inputDenom)
-	outputReserve := k.GetReservePool(outputDenom)
-	params := k.GetFeeParams(ctx)
-
-	numerator := inputReserve.Mul(outputReserve).Mul(params.FeeD)
-	denominator := (outputReserve.Sub(outputAmt)).Mul(parans.FeeN)
-	return numerator.Quo(denominator).Add(sdk.OneInt())
+//
+// pool must use the BackendConstantProduct backend: this formula reads reserves directly off
+// pool.EscrowAddress, which is not where a BackendCosmWasm pool's reserves live.
+func getInputAmount(ctx sdk.Context, k Keeper, pool Pool, outputAmt sdk.Int, inputDenom, outputDenom string) (sdk.Int, sdk.Error) {
+	if pool.Backend != BackendConstantProduct {
+		return sdk.Int{}, Err(DefaultCodespace, "pool %s uses the %s backend; constant-product pricing does not apply", pool.Id, pool.Backend)
+	}
+	inputReserve := k.GetReservePool(ctx, pool, inputDenom)
+	outputReserve := k.GetReservePool(ctx, pool, outputDenom)
+	if !outputAmt.IsPositive() || outputAmt.GTE(outputReserve) {
+		return sdk.Int{}, ErrNotPositive(DefaultCodespace, fmt.Sprintf("output amount (%s) must be positive and less than the reserve (%s)", outputAmt, outputReserve))
+	}
+
+	fee := k.GetFee(ctx)
+	numerator := sdk.NewDecFromInt(inputReserve).MulInt(outputAmt)
+	denominator := sdk.NewDecFromInt(outputReserve.Sub(outputAmt)).Mul(sdk.OneDec().Sub(fee))
+	inputAmt := numerator.Quo(denominator).Ceil().TruncateInt().Add(sdk.OneInt())
+	return inputAmt, nil
 }
 
-// GetOutputAmount returns the amount of coins bought (calculated) given the input amount being sold (exact)
-// The fee is included in the input coins being bought
+// getOutputAmount returns the exact amount of outputDenom received from pool for an exact
+// inputAmt of inputDenom, after the pool fee is deducted from the traded amount:
+//
+//	outputAmt = inputAmt * (1 - fee) * outputReserve / (inputReserve + inputAmt * (1 - fee))
+//
 // https://github.com/runtimeverification/verified-smart-contracts/blob/uniswap/uniswap/x-y-k.pdf
-// TODO: replace FeeD and FeeN with updated formula using fee as sdk.Dec
-func getOutputAmount(ctx sdk.Context, k Keeper, inputAmt sdk.Int, inputDenom, outputDenom string) sdk.Int {
-	inputReserve := k.GetReservePool(inputDenom)
-	outputReserve := k.GetReservePool(outputDenom)
-	params := k.GetFeeParams(ctx)
-
-	inputAmtWithFee := inputAmt.Mul(params.FeeN)
-	numerator := inputAmtWithFee.Mul(outputReserve)
-	denominator := inputReserve.Mul(params.FeeD).Add(inputAmtWithFee)
-	return numerator.Quo(denominator)
+//
+// pool must use the BackendConstantProduct backend: this formula reads reserves directly off
+// pool.EscrowAddress, which is not where a BackendCosmWasm pool's reserves live. Unlike
+// getInputAmount, there is no reserve-based check that would otherwise catch this, since an
+// output reserve read as zero just prices the swap as zero instead of erroring.
+func getOutputAmount(ctx sdk.Context, k Keeper, pool Pool, inputAmt sdk.Int, inputDenom, outputDenom string) (sdk.Int, sdk.Error) {
+	if pool.Backend != BackendConstantProduct {
+		return sdk.Int{}, Err(DefaultCodespace, "pool %s uses the %s backend; constant-product pricing does not apply", pool.Id, pool.Backend)
+	}
+	if !inputAmt.IsPositive() {
+		return sdk.Int{}, ErrNotPositive(DefaultCodespace, "input amount must be positive")
+	}
+	inputReserve := k.GetReservePool(ctx, pool, inputDenom)
+	outputReserve := k.GetReservePool(ctx, pool, outputDenom)
+
+	fee := k.GetFee(ctx)
+	inputAmtWithFee := sdk.NewDecFromInt(inputAmt).Mul(sdk.OneDec().Sub(fee))
+	numerator := inputAmtWithFee.MulInt(outputReserve)
+	denominator := sdk.NewDecFromInt(inputReserve).Add(inputAmtWithFee)
+	return numerator.Quo(denominator).TruncateInt(), nil
 }
@@ -0,0 +1,247 @@
+package uniswap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	testNativeDenom = "stake"
+	testOtherDenom  = "atom"
+	testThirdDenom  = "osmo"
+)
+
+// testBankKeeper is a minimal in-memory BankKeeper used only to back pool escrow balances in
+// these tests; it does not enforce the invariants a real bank keeper would.
+type testBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newTestBankKeeper() *testBankKeeper {
+	return &testBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (bk *testBankKeeper) GetCoins(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.balances[addr.String()]
+}
+
+func (bk *testBankKeeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool {
+	return bk.GetCoins(ctx, addr).IsAllGTE(amt)
+}
+
+func (bk *testBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if !bk.HasCoins(ctx, fromAddr, amt) {
+		return sdk.ErrInsufficientCoins("insufficient funds")
+	}
+	bk.balances[fromAddr.String()] = bk.GetCoins(ctx, fromAddr).Sub(amt)
+	bk.balances[toAddr.String()] = bk.GetCoins(ctx, toAddr).Add(amt)
+	return nil
+}
+
+// setReserves seeds pool's escrow account with the given reserves of the native and counterparty denoms
+func (bk *testBankKeeper) setReserves(pool Pool, standardAmt, counterpartyAmt sdk.Int) {
+	bk.balances[pool.EscrowAddress.String()] = sdk.NewCoins(
+		sdk.NewCoin(pool.Standard, standardAmt),
+		sdk.NewCoin(pool.Counterparty, counterpartyAmt),
+	)
+}
+
+// setupTestKeeper returns a Keeper with a fresh in-memory store and a single pool created
+// between testNativeDenom and testOtherDenom, seeded with the given reserves.
+func setupTestKeeper(t *testing.T, standardReserve, counterpartyReserve sdk.Int) (sdk.Context, Keeper, *testBankKeeper, Pool) {
+	storeKey := sdk.NewKVStoreKey(StoreKey)
+	paramsKey := sdk.NewKVStoreKey("params")
+	paramsTKey := sdk.NewTransientStoreKey("transient_params")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	pk := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	subspace := pk.Subspace(ModuleName)
+
+	bk := newTestBankKeeper()
+	k := NewKeeper(cdc, storeKey, bk, nil, nil, subspace)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Now()}, false, log.NewNopLogger())
+	k.SetFee(ctx, DefaultFee())
+	k.SetNativeDenom(ctx, testNativeDenom)
+
+	pool := k.CreateReservePool(ctx, testOtherDenom)
+	bk.setReserves(pool, standardReserve, counterpartyReserve)
+
+	return ctx, k, bk, pool
+}
+
+// setupMultiHopTestKeeper returns a Keeper with a fresh in-memory store and two pools, both
+// paired against testNativeDenom: one with testOtherDenom, one with testThirdDenom. This gives a
+// testOtherDenom -> testNativeDenom -> testThirdDenom route with two hops.
+func setupMultiHopTestKeeper(t *testing.T) (sdk.Context, Keeper, *testBankKeeper, Pool, Pool) {
+	storeKey := sdk.NewKVStoreKey(StoreKey)
+	paramsKey := sdk.NewKVStoreKey("params")
+	paramsTKey := sdk.NewTransientStoreKey("transient_params")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	pk := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	subspace := pk.Subspace(ModuleName)
+
+	bk := newTestBankKeeper()
+	k := NewKeeper(cdc, storeKey, bk, nil, nil, subspace)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Now()}, false, log.NewNopLogger())
+	k.SetFee(ctx, DefaultFee())
+	k.SetNativeDenom(ctx, testNativeDenom)
+
+	poolA := k.CreateReservePool(ctx, testOtherDenom)
+	bk.setReserves(poolA, sdk.NewInt(1000000), sdk.NewInt(2000000))
+
+	poolB := k.CreateReservePool(ctx, testThirdDenom)
+	bk.setReserves(poolB, sdk.NewInt(1000000), sdk.NewInt(500000))
+
+	return ctx, k, bk, poolA, poolB
+}
+
+func TestHandleMsgSwapOrder_SellOrderRoutesPoolToPoolWithoutTouchingSender(t *testing.T) {
+	ctx, k, bk, _, _ := setupMultiHopTestKeeper(t)
+
+	sender := sdk.AccAddress([]byte("test-sender-address"))
+	senderInput := sdk.NewInt(10000)
+	bk.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(testOtherDenom, senderInput))
+
+	msg := NewMsgSwapOrder(
+		sdk.NewCoin(testOtherDenom, senderInput),
+		sdk.NewCoin(testThirdDenom, sdk.OneInt()),
+		nil,
+		sender,
+		time.Now().Add(time.Hour),
+		false,
+	)
+
+	res := HandleMsgSwapOrder(ctx, msg, k)
+	require.Equal(t, sdk.CodeType(0), res.Code, res.Log)
+
+	senderBalance := bk.GetCoins(ctx, sender)
+	require.True(t, senderBalance.AmountOf(testOtherDenom).IsZero(), "sender's input denom should be fully spent")
+	require.True(t, senderBalance.AmountOf(testNativeDenom).IsZero(), "sender should never hold the intermediate native hop")
+	require.True(t, senderBalance.AmountOf(testThirdDenom).IsPositive(), "sender should receive the route's output denom")
+}
+
+func TestHandleMsgSwapOrder_BuyOrderRoutesPoolToPoolWithoutTouchingSender(t *testing.T) {
+	ctx, k, bk, _, _ := setupMultiHopTestKeeper(t)
+
+	sender := sdk.AccAddress([]byte("test-sender-address"))
+	senderMaxInput := sdk.NewInt(1000000)
+	bk.balances[sender.String()] = sdk.NewCoins(sdk.NewCoin(testOtherDenom, senderMaxInput))
+
+	desiredOutput := sdk.NewInt(1000)
+	msg := NewMsgSwapOrder(
+		sdk.NewCoin(testOtherDenom, senderMaxInput),
+		sdk.NewCoin(testThirdDenom, desiredOutput),
+		nil,
+		sender,
+		time.Now().Add(time.Hour),
+		true,
+	)
+
+	// a buy order across this two-hop route requires the A->native hop to settle before the
+	// native->C hop, since the latter is paid for out of the former's proceeds; this previously
+	// failed with insufficient funds when the hops were settled back to front
+	res := HandleMsgSwapOrder(ctx, msg, k)
+	require.Equal(t, sdk.CodeType(0), res.Code, res.Log)
+
+	senderBalance := bk.GetCoins(ctx, sender)
+	require.True(t, senderBalance.AmountOf(testNativeDenom).IsZero(), "sender should never hold the intermediate native hop")
+	require.True(t, senderBalance.AmountOf(testThirdDenom).Equal(desiredOutput))
+	require.True(t, senderBalance.AmountOf(testOtherDenom).LT(senderMaxInput), "sender should have paid a positive, bounded amount of the input denom")
+}
+
+func TestGetOutputAmount_PreservesInvariant(t *testing.T) {
+	standardReserve, counterpartyReserve := sdk.NewInt(1000000), sdk.NewInt(2000000)
+	ctx, k, bk, pool := setupTestKeeper(t, standardReserve, counterpartyReserve)
+
+	outputAmt, err := getOutputAmount(ctx, k, pool, sdk.NewInt(1000), testNativeDenom, testOtherDenom)
+	require.Nil(t, err)
+	require.True(t, outputAmt.IsPositive())
+
+	newStandard := standardReserve.Add(sdk.NewInt(1000))
+	newCounterparty := counterpartyReserve.Sub(outputAmt)
+	bk.setReserves(pool, newStandard, newCounterparty)
+
+	k0 := standardReserve.Mul(counterpartyReserve)
+	k1 := newStandard.Mul(newCounterparty)
+	require.True(t, k1.GTE(k0), "invariant violated: k went from %s to %s", k0, k1)
+}
+
+func TestGetInputAmount_RoundsInFavorOfPool(t *testing.T) {
+	ctx, k, _, pool := setupTestKeeper(t, sdk.NewInt(1000000), sdk.NewInt(2000000))
+
+	desiredOutput := sdk.NewInt(5000)
+	inputAmt, err := getInputAmount(ctx, k, pool, desiredOutput, testNativeDenom, testOtherDenom)
+	require.Nil(t, err)
+	require.True(t, inputAmt.IsPositive())
+
+	// selling the computed input back through getOutputAmount must yield at least the output
+	// that was asked for; any shortfall would mean the pool under-charged the trader
+	actualOutput, err := getOutputAmount(ctx, k, pool, inputAmt, testNativeDenom, testOtherDenom)
+	require.Nil(t, err)
+	require.True(t, actualOutput.GTE(desiredOutput), "rounding favored the trader: wanted %s, got %s", desiredOutput, actualOutput)
+}
+
+func TestGetOutputAmount_ErrorsOnNonPositiveInput(t *testing.T) {
+	ctx, k, _, pool := setupTestKeeper(t, sdk.NewInt(1000000), sdk.NewInt(2000000))
+
+	_, err := getOutputAmount(ctx, k, pool, sdk.ZeroInt(), testNativeDenom, testOtherDenom)
+	require.NotNil(t, err)
+}
+
+func TestGetOutputAmount_ErrorsOnCosmWasmPool(t *testing.T) {
+	ctx, k, _, _ := setupTestKeeper(t, sdk.NewInt(1000000), sdk.NewInt(2000000))
+
+	// a BackendCosmWasm pool's reserves live at ContractAddress, not EscrowAddress, so the
+	// constant-product formula must refuse to price it rather than read an empty reserve
+	wasmPool := k.CreateCosmWasmPool(ctx, "wasm", sdk.AccAddress([]byte("test-contract-address")))
+
+	_, err := getOutputAmount(ctx, k, wasmPool, sdk.NewInt(1000), testNativeDenom, "wasm")
+	require.NotNil(t, err)
+
+	_, err = getInputAmount(ctx, k, wasmPool, sdk.NewInt(1000), testNativeDenom, "wasm")
+	require.NotNil(t, err)
+}
+
+func TestGetInputAmount_ErrorsWhenOutputAmtExceedsReserve(t *testing.T) {
+	standardReserve, counterpartyReserve := sdk.NewInt(1000000), sdk.NewInt(2000000)
+	ctx, k, _, pool := setupTestKeeper(t, standardReserve, counterpartyReserve)
+
+	// requesting the entire reserve (or more) cannot be priced without dividing by zero or a
+	// negative denominator, and must be rejected rather than panic
+	_, err := getInputAmount(ctx, k, pool, counterpartyReserve, testNativeDenom, testOtherDenom)
+	require.NotNil(t, err)
+
+	_, err = getInputAmount(ctx, k, pool, counterpartyReserve.Add(sdk.NewInt(1)), testNativeDenom, testOtherDenom)
+	require.NotNil(t, err)
+}
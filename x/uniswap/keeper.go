@@ -0,0 +1,166 @@
+package uniswap
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+)
+
+// BankKeeper defines the contract that the uniswap module requires of the bank keeper
+type BankKeeper interface {
+	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+}
+
+// SupplyKeeper defines the contract that the uniswap module requires of the supply keeper
+type SupplyKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) sdk.Error
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+}
+
+// WasmKeeper defines the contract that the uniswap module requires of the wasm keeper in order to
+// settle swaps and liquidity operations against a BackendCosmWasm pool
+type WasmKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// Keeper of the uniswap store
+type Keeper struct {
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
+	bk         BankKeeper
+	sk         SupplyKeeper
+	wk         WasmKeeper
+	paramSpace params.Subspace
+}
+
+// NewKeeper creates a uniswap keeper. wk may be nil in a chain that does not register any
+// BackendCosmWasm pools.
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, bk BankKeeper, sk SupplyKeeper, wk WasmKeeper, paramSpace params.Subspace) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		bk:         bk,
+		sk:         sk,
+		wk:         wk,
+		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
+	}
+}
+
+// store key prefixes
+var (
+	poolKeyPrefix        = []byte{0x01} // counterparty denom -> Pool
+	poolByLptDenomPrefix = []byte{0x02} // lpt denom -> counterparty denom
+	poolSequenceKey      = []byte{0x03}
+)
+
+func poolKey(denom string) []byte {
+	return append(poolKeyPrefix, []byte(denom)...)
+}
+
+func poolByLptDenomKey(lptDenom string) []byte {
+	return append(poolByLptDenomPrefix, []byte(lptDenom)...)
+}
+
+// getNextPoolSequence returns the next unused pool sequence number and persists the increment;
+// a pool's LP-token denom (lpt-<sequence>) is derived from the value returned here.
+func (k Keeper) getNextPoolSequence(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var seq uint64
+	if bz := store.Get(poolSequenceKey); bz != nil {
+		seq = binary.BigEndian.Uint64(bz)
+	}
+	next := make([]byte, 8)
+	binary.BigEndian.PutUint64(next, seq+1)
+	store.Set(poolSequenceKey, next)
+	return seq
+}
+
+// HasReservePool returns whether a pool already exists for the given counterparty denom
+func (k Keeper) HasReservePool(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(poolKey(denom))
+}
+
+// CreateReservePool creates and persists a new constant-product Pool pairing denom against the
+// native asset
+func (k Keeper) CreateReservePool(ctx sdk.Context, denom string) Pool {
+	pool := NewPool(k.getNextPoolSequence(ctx), k.GetNativeDenom(ctx), denom, BackendConstantProduct, nil)
+	k.SetPool(ctx, pool)
+	return pool
+}
+
+// CreateCosmWasmPool creates and persists a new Pool pairing denom against the native asset,
+// priced and settled by the CosmWasm contract at contractAddress rather than the constant-product
+// formula
+func (k Keeper) CreateCosmWasmPool(ctx sdk.Context, denom string, contractAddress sdk.AccAddress) Pool {
+	pool := NewPool(k.getNextPoolSequence(ctx), k.GetNativeDenom(ctx), denom, BackendCosmWasm, contractAddress)
+	k.SetPool(ctx, pool)
+	return pool
+}
+
+// SetPool persists pool, indexed by both its counterparty denom and its LP-token denom
+func (k Keeper) SetPool(ctx sdk.Context, pool Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(poolKey(pool.Counterparty), k.cdc.MustMarshalBinaryLengthPrefixed(pool))
+	store.Set(poolByLptDenomKey(pool.LptDenom), []byte(pool.Counterparty))
+}
+
+// GetPool returns the pool paired against denom
+func (k Keeper) GetPool(ctx sdk.Context, denom string) (Pool, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(poolKey(denom))
+	if bz == nil {
+		return Pool{}, false
+	}
+	var pool Pool
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pool)
+	return pool, true
+}
+
+// GetPoolByDenom is an alias of GetPool kept for readability at call sites keying off an
+// arbitrary pair denom rather than a pool id
+func (k Keeper) GetPoolByDenom(ctx sdk.Context, denom string) (Pool, bool) {
+	return k.GetPool(ctx, denom)
+}
+
+// GetPoolByLptDenom returns the pool whose LP shares are denominated in lptDenom
+func (k Keeper) GetPoolByLptDenom(ctx sdk.Context, lptDenom string) (Pool, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(poolByLptDenomKey(lptDenom))
+	if bz == nil {
+		return Pool{}, false
+	}
+	return k.GetPool(ctx, string(bz))
+}
+
+// GetReservePool returns pool's current balance of denom, read directly off its escrow account
+func (k Keeper) GetReservePool(ctx sdk.Context, pool Pool, denom string) sdk.Int {
+	return k.bk.GetCoins(ctx, pool.EscrowAddress).AmountOf(denom)
+}
+
+// getLptSupply returns the total number of outstanding LP shares for lptDenom
+func (k Keeper) getLptSupply(ctx sdk.Context, lptDenom string) sdk.Int {
+	return k.sk.GetSupply(ctx).GetTotal().AmountOf(lptDenom)
+}
+
+// GetAllPools returns every reserve pool currently registered, ordered by counterparty denom
+func (k Keeper) GetAllPools(ctx sdk.Context) []Pool {
+	var pools []Pool
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, poolKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool Pool
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pool)
+		pools = append(pools, pool)
+	}
+	return pools
+}
@@ -0,0 +1,88 @@
+package uniswap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "uniswap"
+
+	// StoreKey is the default store key for uniswap
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for uniswap
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for uniswap
+	QuerierRoute = ModuleName
+)
+
+// parameter store keys
+var (
+	ParamStoreKeyFee         = []byte("fee")
+	ParamStoreKeyNativeDenom = []byte("nativedenom")
+)
+
+// ParamKeyTable returns the param key table for the uniswap module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		params.NewParamSetPair(ParamStoreKeyFee, sdk.Dec{}, validateFee),
+		params.NewParamSetPair(ParamStoreKeyNativeDenom, "", validateNativeDenom),
+	)
+}
+
+// DefaultFee returns the default uniswap fee, 0.3%, mirroring Uniswap v1
+func DefaultFee() sdk.Dec {
+	return sdk.NewDecWithPrec(3, 3)
+}
+
+func validateFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("uniswap fee cannot be negative: %s", v)
+	}
+	if !v.LT(sdk.OneDec()) {
+		return fmt.Errorf("uniswap fee must be less than 1: %s", v)
+	}
+	return nil
+}
+
+func validateNativeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return sdk.ValidateDenom(v)
+}
+
+// GetFee returns the currently configured swap fee, a fraction of the input amount in [0, 1)
+func (k Keeper) GetFee(ctx sdk.Context) sdk.Dec {
+	var fee sdk.Dec
+	k.paramSpace.Get(ctx, ParamStoreKeyFee, &fee)
+	return fee
+}
+
+// SetFee sets the swap fee
+func (k Keeper) SetFee(ctx sdk.Context, fee sdk.Dec) {
+	k.paramSpace.Set(ctx, ParamStoreKeyFee, &fee)
+}
+
+// GetNativeDenom returns the denom treated as the native asset that every
+// reserve pool is paired against
+func (k Keeper) GetNativeDenom(ctx sdk.Context) string {
+	var nativeDenom string
+	k.paramSpace.Get(ctx, ParamStoreKeyNativeDenom, &nativeDenom)
+	return nativeDenom
+}
+
+// SetNativeDenom sets the native asset denom
+func (k Keeper) SetNativeDenom(ctx sdk.Context, denom string) {
+	k.paramSpace.Set(ctx, ParamStoreKeyNativeDenom, &denom)
+}
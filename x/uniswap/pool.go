@@ -0,0 +1,52 @@
+package uniswap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// pool backend types, naming which PoolBackend implementation prices and settles a pool
+const (
+	BackendConstantProduct = "constant_product"
+	BackendCosmWasm        = "cosmwasm"
+)
+
+// Pool represents a single trading pair's reserves and its LP-token denom. Reserves are not
+// cached on the Pool itself: for a BackendConstantProduct pool they are simply the coin balance
+// held by EscrowAddress, so a pool's LP shares (LptDenom) are ordinary bank coins that can be
+// transferred, queried and composed with the rest of the chain (IBC, staking, gov) like any other
+// denom. Backend names the PoolBackend that prices and settles the pool; ContractAddress is only
+// set for a BackendCosmWasm pool, naming the contract that backend delegates to.
+type Pool struct {
+	Id              string         `json:"id"`
+	EscrowAddress   sdk.AccAddress `json:"escrow_address"`
+	Standard        string         `json:"standard"`     // denom of the native asset side of the pair
+	Counterparty    string         `json:"counterparty"` // denom of the non-native side of the pair
+	LptDenom        string         `json:"lpt_denom"`
+	Backend         string         `json:"backend"`
+	ContractAddress sdk.AccAddress `json:"contract_address,omitempty"`
+}
+
+// NewPool creates the Pool pairing standard against counterparty, deriving its LP-token denom
+// from seq and priced and settled by the named backend. contractAddress is only meaningful for
+// BackendCosmWasm pools and should be nil otherwise.
+func NewPool(seq uint64, standard, counterparty, backend string, contractAddress sdk.AccAddress) Pool {
+	lptDenom := fmt.Sprintf("lpt-%d", seq)
+	return Pool{
+		Id:              counterparty,
+		EscrowAddress:   escrowAddress(lptDenom),
+		Standard:        standard,
+		Counterparty:    counterparty,
+		LptDenom:        lptDenom,
+		Backend:         backend,
+		ContractAddress: contractAddress,
+	}
+}
+
+// escrowAddress deterministically derives a pool's escrow account from its LP-token denom, the
+// same way the supply module derives module account addresses from module names.
+func escrowAddress(lptDenom string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(ModuleName + ":" + lptDenom)))
+}
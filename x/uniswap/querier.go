@@ -0,0 +1,229 @@
+package uniswap
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// uniswap query endpoints, mirroring the surface a stargate gRPC Query service would expose
+// (Pool, Pools, LiquidityPool, EstimateSwap) over this module's legacy ABCI querier
+const (
+	QueryPool          = "pool"
+	QueryPools         = "pools"
+	QueryLiquidityPool = "liquidity_pool"
+	QueryEstimateSwap  = "estimate_swap"
+)
+
+// NewQuerier creates a querier for the uniswap module
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryPool:
+			return queryPool(ctx, path[1:], k)
+		case QueryPools:
+			return queryPools(ctx, req, k)
+		case QueryLiquidityPool:
+			return queryLiquidityPool(ctx, path[1:], k)
+		case QueryEstimateSwap:
+			return queryEstimateSwap(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown uniswap query endpoint: %s", path[0]))
+		}
+	}
+}
+
+// QueryPoolResponse is the response type for the pool, pools and liquidity_pool queries
+type QueryPoolResponse struct {
+	Pool                Pool    `json:"pool"`
+	StandardReserve     sdk.Int `json:"standard_reserve"`
+	CounterpartyReserve sdk.Int `json:"counterparty_reserve"`
+	LptSupply           sdk.Int `json:"lpt_supply"`
+}
+
+func newQueryPoolResponse(ctx sdk.Context, k Keeper, pool Pool) QueryPoolResponse {
+	backend := k.backendFor(pool)
+	return QueryPoolResponse{
+		Pool:                pool,
+		StandardReserve:     backend.GetReserves(ctx, pool, pool.Standard),
+		CounterpartyReserve: backend.GetReserves(ctx, pool, pool.Counterparty),
+		LptSupply:           k.getLptSupply(ctx, pool.LptDenom),
+	}
+}
+
+// queryPool looks up a single pool by its counterparty denom (path[0])
+func queryPool(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || path[0] == "" {
+		return nil, ErrNonExistingPool(DefaultCodespace, "pool denom is required")
+	}
+	pool, found := k.GetPool(ctx, path[0])
+	if !found {
+		return nil, ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no reserve pool for denom %s", path[0]))
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(newQueryPoolResponse(ctx, k, pool))
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal pool: %s", err))
+	}
+	return bz, nil
+}
+
+// queryLiquidityPool looks up a single pool by its LP-token denom (path[0])
+func queryLiquidityPool(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || path[0] == "" {
+		return nil, ErrNonExistingPool(DefaultCodespace, "lpt denom is required")
+	}
+	pool, found := k.GetPoolByLptDenom(ctx, path[0])
+	if !found {
+		return nil, ErrNonExistingPool(DefaultCodespace, fmt.Sprintf("no pool for lpt denom %s", path[0]))
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(newQueryPoolResponse(ctx, k, pool))
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal pool: %s", err))
+	}
+	return bz, nil
+}
+
+// QueryPoolsParams is the parameter type for the pools query, a simple page/limit pagination
+type QueryPoolsParams struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+func queryPools(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryPoolsParams
+	if err := ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.Limit <= 0 {
+		params.Limit = 100
+	}
+
+	pools := k.GetAllPools(ctx)
+	start := (params.Page - 1) * params.Limit
+	if start >= len(pools) {
+		pools = []Pool{}
+	} else {
+		end := start + params.Limit
+		if end > len(pools) {
+			end = len(pools)
+		}
+		pools = pools[start:end]
+	}
+
+	responses := make([]QueryPoolResponse, len(pools))
+	for i, pool := range pools {
+		responses[i] = newQueryPoolResponse(ctx, k, pool)
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(responses)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal pools: %s", err))
+	}
+	return bz, nil
+}
+
+// QueryEstimateSwapParams is the parameter type for the estimate_swap query. For a sell order
+// (IsBuyOrder false) Input.Amount is the exact amount to be sold and Output.Denom names what is
+// bought; for a buy order (IsBuyOrder true) Output.Amount is the exact amount to be bought and
+// Input.Denom names what is sold. Path is optional and has the same meaning as MsgSwapOrder.Path.
+type QueryEstimateSwapParams struct {
+	Input      sdk.Coin `json:"input"`
+	Output     sdk.Coin `json:"output"`
+	Path       []string `json:"path"`
+	IsBuyOrder bool     `json:"is_buy_order"`
+}
+
+// QueryEstimateSwapResponse reports the counter-amount a swap would realize along with its
+// effective price, without mutating any state
+type QueryEstimateSwapResponse struct {
+	Input  sdk.Coin `json:"input"`
+	Output sdk.Coin `json:"output"`
+	Price  sdk.Dec  `json:"price"` // output amount per unit of input
+}
+
+// queryEstimateSwap prices a would-be swap without moving any funds. A direct (single-hop) route
+// against a BackendCosmWasm pool is priced via that pool's SpotPrice, the only non-mutating quote
+// its backend offers; every other route composes the constant-product formula across its hops via
+// getInputPrice/getOutputPrice, which reject any BackendCosmWasm pool they cross, since a multi-hop
+// route has no way to invoke a contract mid-route from a read-only query path.
+func queryEstimateSwap(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryEstimateSwapParams
+	if err := ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	route, rErr := resolveRoute(ctx, k, params.Input.Denom, params.Output.Denom, params.Path)
+	if rErr != nil {
+		return nil, rErr
+	}
+
+	var directPool Pool
+	direct := false
+	if len(route) == 2 {
+		if pool, found := k.poolForHop(ctx, route[0], route[1]); found && pool.Backend != BackendConstantProduct {
+			directPool, direct = pool, true
+		}
+	}
+
+	resp := QueryEstimateSwapResponse{Input: params.Input, Output: params.Output}
+	if params.IsBuyOrder {
+		var inputAmt sdk.Int
+		var err sdk.Error
+		if direct {
+			inputAmt, err = estimateSwapViaSpotPrice(ctx, k, directPool, params.Input.Denom, params.Output.Denom, params.Output.Amount, true)
+		} else {
+			inputAmt, err = getInputPrice(ctx, k, route, params.Output.Amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp.Input = sdk.NewCoin(params.Input.Denom, inputAmt)
+	} else {
+		var outputAmt sdk.Int
+		var err sdk.Error
+		if direct {
+			outputAmt, err = estimateSwapViaSpotPrice(ctx, k, directPool, params.Input.Denom, params.Output.Denom, params.Input.Amount, false)
+		} else {
+			outputAmt, err = getOutputPrice(ctx, k, route, params.Input.Amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+		resp.Output = sdk.NewCoin(params.Output.Denom, outputAmt)
+	}
+	if resp.Input.Amount.IsPositive() {
+		resp.Price = sdk.NewDecFromInt(resp.Output.Amount).QuoInt(resp.Input.Amount)
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(resp)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to marshal estimate: %s", err))
+	}
+	return bz, nil
+}
+
+// estimateSwapViaSpotPrice prices a direct swap against a non-constant-product pool by linearly
+// extrapolating its backend's SpotPrice quote for 1 unit; this is the only pricing a read-only
+// query can obtain for such a pool, since a real quote would require invoking its contract.
+func estimateSwapViaSpotPrice(ctx sdk.Context, k Keeper, pool Pool, inputDenom, outputDenom string, amt sdk.Int, isBuyOrder bool) (sdk.Int, sdk.Error) {
+	backend := k.backendFor(pool)
+	if isBuyOrder {
+		price, err := backend.SpotPrice(ctx, pool, inputDenom, outputDenom)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		return sdk.NewDecFromInt(amt).Mul(price).Ceil().TruncateInt(), nil
+	}
+	price, err := backend.SpotPrice(ctx, pool, outputDenom, inputDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return sdk.NewDecFromInt(amt).Mul(price).TruncateInt(), nil
+}
@@ -0,0 +1,256 @@
+package uniswap
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// uniswap message types
+const (
+	TypeMsgSwapOrder              = "swap_order"
+	TypeMsgAddLiquidity           = "add_liquidity"
+	TypeMsgRemoveLiquidity        = "remove_liquidity"
+	TypeMsgAddUnilateralLiquidity = "add_unilateral_liquidity"
+)
+
+// MsgSwapOrder represents a message to swap Input for Output where Input and Output are both Coins.
+// IsBuyOrder indicates whether the order is a buy (fixed output, limit on input) or a sell (fixed input, limit on output).
+// Path is an ordered list of denoms the swap hops through between Input.Denom and Output.Denom
+// (e.g. A, native, C for an A->C swap); when left empty it is inferred to go directly between
+// Input and Output if either one is the native asset, or through the native asset otherwise.
+type MsgSwapOrder struct {
+	Input      sdk.Coin       `json:"input"`
+	Output     sdk.Coin       `json:"output"`
+	Path       []string       `json:"path"`
+	Sender     sdk.AccAddress `json:"sender"`
+	Deadline   time.Time      `json:"deadline"`
+	IsBuyOrder bool           `json:"is_buy_order"`
+}
+
+// NewMsgSwapOrder creates a new MsgSwapOrder object.
+func NewMsgSwapOrder(input, output sdk.Coin, path []string, sender sdk.AccAddress, deadline time.Time, isBuyOrder bool) MsgSwapOrder {
+	return MsgSwapOrder{
+		Input:      input,
+		Output:     output,
+		Path:       path,
+		Sender:     sender,
+		Deadline:   deadline,
+		IsBuyOrder: isBuyOrder,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgSwapOrder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSwapOrder) Type() string { return TypeMsgSwapOrder }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSwapOrder) ValidateBasic() sdk.Error {
+	if !msg.Input.IsValid() || !msg.Input.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Input.String())
+	}
+	if !msg.Output.IsValid() || !msg.Output.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Output.String())
+	}
+	if msg.Input.Denom == msg.Output.Denom {
+		return ErrEqualDenom(DefaultCodespace, "input and output denom cannot be the same")
+	}
+	for _, denom := range msg.Path {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdk.ErrInvalidCoins(err.Error())
+		}
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("sender address cannot be empty")
+	}
+	if msg.Deadline.IsZero() {
+		return ErrInvalidDeadline(DefaultCodespace, "deadline cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSwapOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSwapOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgAddLiquidity represents a message to add liquidity to an existing or new reserve pool.
+// A sender adds an exact amount of the native asset (DepositAmount) and is willing to deposit
+// up to Deposit.Amount of Deposit.Denom; MinReward protects against slippage on the minted LP shares.
+type MsgAddLiquidity struct {
+	Deposit       sdk.Coin       `json:"deposit"`
+	DepositAmount sdk.Int        `json:"deposit_amount"`
+	MinReward     sdk.Int        `json:"min_reward"`
+	Sender        sdk.AccAddress `json:"sender"`
+	Deadline      time.Time      `json:"deadline"`
+}
+
+// NewMsgAddLiquidity creates a new MsgAddLiquidity object.
+func NewMsgAddLiquidity(deposit sdk.Coin, depositAmount, minReward sdk.Int, sender sdk.AccAddress, deadline time.Time) MsgAddLiquidity {
+	return MsgAddLiquidity{
+		Deposit:       deposit,
+		DepositAmount: depositAmount,
+		MinReward:     minReward,
+		Sender:        sender,
+		Deadline:      deadline,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAddLiquidity) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAddLiquidity) Type() string { return TypeMsgAddLiquidity }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddLiquidity) ValidateBasic() sdk.Error {
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Deposit.String())
+	}
+	if !msg.DepositAmount.IsPositive() {
+		return ErrNotPositive(DefaultCodespace, "deposit amount must be positive")
+	}
+	if msg.MinReward.IsNegative() {
+		return ErrNotPositive(DefaultCodespace, "minimum reward cannot be negative")
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("sender address cannot be empty")
+	}
+	if msg.Deadline.IsZero() {
+		return ErrInvalidDeadline(DefaultCodespace, "deadline cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAddLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgRemoveLiquidity represents a message to remove liquidity from an existing reserve pool by
+// burning WithdrawAmount of the sender's LP shares in the pool paired against Withdraw.Denom;
+// MinNative protects against slippage on the withdrawn native amount. The withdrawn counter-asset
+// amount has no minimum.
+type MsgRemoveLiquidity struct {
+	Withdraw       sdk.Coin       `json:"withdraw"`
+	WithdrawAmount sdk.Int        `json:"withdraw_amount"`
+	MinNative      sdk.Int        `json:"min_native"`
+	Sender         sdk.AccAddress `json:"sender"`
+	Deadline       time.Time      `json:"deadline"`
+}
+
+// NewMsgRemoveLiquidity creates a new MsgRemoveLiquidity object.
+func NewMsgRemoveLiquidity(withdraw sdk.Coin, withdrawAmount, minNative sdk.Int, sender sdk.AccAddress, deadline time.Time) MsgRemoveLiquidity {
+	return MsgRemoveLiquidity{
+		Withdraw:       withdraw,
+		WithdrawAmount: withdrawAmount,
+		MinNative:      minNative,
+		Sender:         sender,
+		Deadline:       deadline,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgRemoveLiquidity) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRemoveLiquidity) Type() string { return TypeMsgRemoveLiquidity }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveLiquidity) ValidateBasic() sdk.Error {
+	if !msg.WithdrawAmount.IsPositive() {
+		return ErrNotPositive(DefaultCodespace, "withdraw amount must be positive")
+	}
+	if msg.MinNative.IsNegative() {
+		return ErrNotPositive(DefaultCodespace, "minimum native amount cannot be negative")
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("sender address cannot be empty")
+	}
+	if msg.Deadline.IsZero() {
+		return ErrInvalidDeadline(DefaultCodespace, "deadline cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRemoveLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRemoveLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgAddUnilateralLiquidity represents a message to add liquidity to an existing reserve pool
+// using only one side of the pair. Deposit.Denom must be either PoolDenom (the pool's
+// counter-asset) or the chain's native asset; whichever side is not deposited is acquired
+// internally via a virtual swap against the pool's own reserves. MinLiquidity protects against
+// slippage on the minted LP shares.
+type MsgAddUnilateralLiquidity struct {
+	PoolDenom    string         `json:"pool_denom"`
+	Deposit      sdk.Coin       `json:"deposit"`
+	MinLiquidity sdk.Int        `json:"min_liquidity"`
+	Sender       sdk.AccAddress `json:"sender"`
+	Deadline     time.Time      `json:"deadline"`
+}
+
+// NewMsgAddUnilateralLiquidity creates a new MsgAddUnilateralLiquidity object.
+func NewMsgAddUnilateralLiquidity(poolDenom string, deposit sdk.Coin, minLiquidity sdk.Int, sender sdk.AccAddress, deadline time.Time) MsgAddUnilateralLiquidity {
+	return MsgAddUnilateralLiquidity{
+		PoolDenom:    poolDenom,
+		Deposit:      deposit,
+		MinLiquidity: minLiquidity,
+		Sender:       sender,
+		Deadline:     deadline,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAddUnilateralLiquidity) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAddUnilateralLiquidity) Type() string { return TypeMsgAddUnilateralLiquidity }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddUnilateralLiquidity) ValidateBasic() sdk.Error {
+	if err := sdk.ValidateDenom(msg.PoolDenom); err != nil {
+		return sdk.ErrInvalidCoins(err.Error())
+	}
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Deposit.String())
+	}
+	if msg.MinLiquidity.IsNegative() {
+		return ErrNotPositive(DefaultCodespace, "minimum liquidity cannot be negative")
+	}
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress("sender address cannot be empty")
+	}
+	if msg.Deadline.IsZero() {
+		return ErrInvalidDeadline(DefaultCodespace, "deadline cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAddUnilateralLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddUnilateralLiquidity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}